@@ -254,6 +254,7 @@ func (r *runner) Report(opts flag.Options, report types.Report) error {
 		OutputTemplate:     opts.Template,
 		IncludeNonFailures: opts.IncludeNonFailures,
 		Trace:              opts.Trace,
+		QuietIfClean:       opts.QuietIfClean,
 	}); err != nil {
 		return xerrors.Errorf("unable to write results: %w", err)
 	}
@@ -393,7 +394,7 @@ func Run(ctx context.Context, opts flag.Options, targetKind TargetKind) (err err
 		return xerrors.Errorf("report error: %w", err)
 	}
 
-	Exit(opts, report.Results.Failed())
+	Exit(opts, report.Results)
 
 	return nil
 }
@@ -533,8 +534,24 @@ func scan(ctx context.Context, opts flag.Options, initializeScanner InitializeSc
 	return report, nil
 }
 
-func Exit(opts flag.Options, failedResults bool) {
-	if opts.ExitCode != 0 && failedResults {
-		os.Exit(opts.ExitCode)
+// Exit exits with the highest exit code applicable to the results.
+// '--exit-code' applies when any security issue is found,
+// and '--severity-exit-map' applies per severity of the security issues found.
+func Exit(opts flag.Options, results types.Results) {
+	if code := exitCode(opts, results); code != 0 {
+		os.Exit(code)
 	}
 }
+
+func exitCode(opts flag.Options, results types.Results) int {
+	var code int
+	if opts.ExitCode != 0 && results.Failed() {
+		code = opts.ExitCode
+	}
+	for _, severity := range results.Severities() {
+		if c := opts.SeverityExitMap[severity]; c > code {
+			code = c
+		}
+	}
+	return code
+}
@@ -0,0 +1,157 @@
+package artifact
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	dbTypes "github.com/aquasecurity/trivy-db/pkg/types"
+	ftypes "github.com/aquasecurity/trivy/pkg/fanal/types"
+	"github.com/aquasecurity/trivy/pkg/flag"
+	"github.com/aquasecurity/trivy/pkg/types"
+)
+
+func Test_exitCode(t *testing.T) {
+	results := types.Results{
+		{
+			Target: "alpine:3.16 (alpine 3.16.0)",
+			Vulnerabilities: []types.DetectedVulnerability{
+				{
+					VulnerabilityID: "CVE-2022-0001",
+					Vulnerability: dbTypes.Vulnerability{
+						Severity: dbTypes.SeverityHigh.String(),
+					},
+				},
+				{
+					VulnerabilityID: "CVE-2022-0002",
+					Vulnerability: dbTypes.Vulnerability{
+						Severity: dbTypes.SeverityLow.String(),
+					},
+				},
+			},
+		},
+		{
+			Target: "deployment.yaml",
+			Misconfigurations: []types.DetectedMisconfiguration{
+				{
+					ID:       "KSV001",
+					Severity: dbTypes.SeverityMedium.String(),
+					Status:   types.StatusFailure,
+				},
+				{
+					ID:       "KSV002",
+					Severity: dbTypes.SeverityCritical.String(),
+					Status:   types.StatusPassed,
+				},
+			},
+		},
+		{
+			Target: "secret.txt",
+			Secrets: []ftypes.SecretFinding{
+				{
+					RuleID:   "aws-access-key-id",
+					Severity: "",
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		opts    flag.Options
+		results types.Results
+		want    int
+	}{
+		{
+			name:    "no exit code",
+			results: results,
+			want:    0,
+		},
+		{
+			name: "exit code",
+			opts: flag.Options{
+				ReportOptions: flag.ReportOptions{
+					ExitCode: 1,
+				},
+			},
+			results: results,
+			want:    1,
+		},
+		{
+			name: "severity exit map with mixed severities",
+			opts: flag.Options{
+				ReportOptions: flag.ReportOptions{
+					SeverityExitMap: map[dbTypes.Severity]int{
+						dbTypes.SeverityCritical: 4,
+						dbTypes.SeverityHigh:     3,
+						dbTypes.SeverityMedium:   2,
+						dbTypes.SeverityLow:      0,
+					},
+				},
+			},
+			results: results,
+			want:    3,
+		},
+		{
+			name: "severity exit map with unknown severity",
+			opts: flag.Options{
+				ReportOptions: flag.ReportOptions{
+					SeverityExitMap: map[dbTypes.Severity]int{
+						dbTypes.SeverityUnknown: 5,
+					},
+				},
+			},
+			results: results,
+			want:    5,
+		},
+		{
+			name: "exit code is higher than severity exit map",
+			opts: flag.Options{
+				ReportOptions: flag.ReportOptions{
+					ExitCode: 10,
+					SeverityExitMap: map[dbTypes.Severity]int{
+						dbTypes.SeverityHigh: 3,
+					},
+				},
+			},
+			results: results,
+			want:    10,
+		},
+		{
+			name: "passed misconfigurations are not counted",
+			opts: flag.Options{
+				ReportOptions: flag.ReportOptions{
+					ExitCode: 1,
+					SeverityExitMap: map[dbTypes.Severity]int{
+						dbTypes.SeverityCritical: 4,
+					},
+				},
+			},
+			results: types.Results{results[1]},
+			want:    1,
+		},
+		{
+			name: "no security issues",
+			opts: flag.Options{
+				ReportOptions: flag.ReportOptions{
+					ExitCode: 1,
+					SeverityExitMap: map[dbTypes.Severity]int{
+						dbTypes.SeverityCritical: 4,
+					},
+				},
+			},
+			results: types.Results{
+				{
+					Target: "alpine:3.16 (alpine 3.16.0)",
+				},
+			},
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := exitCode(tt.opts, tt.results)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
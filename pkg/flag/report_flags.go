@@ -3,6 +3,8 @@ package flag
 import (
 	"io"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 
 	"golang.org/x/exp/slices"
@@ -71,6 +73,18 @@ var (
 		Value:      0,
 		Usage:      "specify exit code when any security issues are found",
 	}
+	SeverityExitMapFlag = Flag{
+		Name:       "severity-exit-map",
+		ConfigName: "severity-exit-map",
+		Value:      "",
+		Usage:      "specify exit codes per severity of security issues found (e.g. CRITICAL=2,HIGH=1). The highest applicable exit code wins",
+	}
+	QuietIfCleanFlag = Flag{
+		Name:       "quiet-if-clean",
+		ConfigName: "quiet-if-clean",
+		Value:      false,
+		Usage:      "suppress the report output when no security issues are found",
+	}
 	OutputFlag = Flag{
 		Name:       "output",
 		ConfigName: "output",
@@ -90,43 +104,49 @@ var (
 // ReportFlagGroup composes common printer flag structs
 // used for commands requiring reporting logic.
 type ReportFlagGroup struct {
-	Format         *Flag
-	ReportFormat   *Flag
-	Template       *Flag
-	DependencyTree *Flag
-	ListAllPkgs    *Flag
-	IgnoreFile     *Flag
-	IgnorePolicy   *Flag
-	ExitCode       *Flag
-	Output         *Flag
-	Severity       *Flag
+	Format          *Flag
+	ReportFormat    *Flag
+	Template        *Flag
+	DependencyTree  *Flag
+	ListAllPkgs     *Flag
+	IgnoreFile      *Flag
+	IgnorePolicy    *Flag
+	ExitCode        *Flag
+	SeverityExitMap *Flag
+	QuietIfClean    *Flag
+	Output          *Flag
+	Severity        *Flag
 }
 
 type ReportOptions struct {
-	Format         string
-	ReportFormat   string
-	Template       string
-	DependencyTree bool
-	ListAllPkgs    bool
-	IgnoreFile     string
-	ExitCode       int
-	IgnorePolicy   string
-	Output         io.Writer
-	Severities     []dbTypes.Severity
+	Format          string
+	ReportFormat    string
+	Template        string
+	DependencyTree  bool
+	ListAllPkgs     bool
+	IgnoreFile      string
+	ExitCode        int
+	SeverityExitMap map[dbTypes.Severity]int
+	QuietIfClean    bool
+	IgnorePolicy    string
+	Output          io.Writer
+	Severities      []dbTypes.Severity
 }
 
 func NewReportFlagGroup() *ReportFlagGroup {
 	return &ReportFlagGroup{
-		Format:         &FormatFlag,
-		ReportFormat:   &ReportFormatFlag,
-		Template:       &TemplateFlag,
-		DependencyTree: &DependencyTreeFlag,
-		ListAllPkgs:    &ListAllPkgsFlag,
-		IgnoreFile:     &IgnoreFileFlag,
-		IgnorePolicy:   &IgnorePolicyFlag,
-		ExitCode:       &ExitCodeFlag,
-		Output:         &OutputFlag,
-		Severity:       &SeverityFlag,
+		Format:          &FormatFlag,
+		ReportFormat:    &ReportFormatFlag,
+		Template:        &TemplateFlag,
+		DependencyTree:  &DependencyTreeFlag,
+		ListAllPkgs:     &ListAllPkgsFlag,
+		IgnoreFile:      &IgnoreFileFlag,
+		IgnorePolicy:    &IgnorePolicyFlag,
+		ExitCode:        &ExitCodeFlag,
+		SeverityExitMap: &SeverityExitMapFlag,
+		QuietIfClean:    &QuietIfCleanFlag,
+		Output:          &OutputFlag,
+		Severity:        &SeverityFlag,
 	}
 }
 
@@ -136,7 +156,8 @@ func (f *ReportFlagGroup) Name() string {
 
 func (f *ReportFlagGroup) Flags() []*Flag {
 	return []*Flag{f.Format, f.ReportFormat, f.Template, f.DependencyTree, f.ListAllPkgs, f.IgnoreFile,
-		f.IgnorePolicy, f.ExitCode, f.Output, f.Severity}
+		f.IgnorePolicy, f.ExitCode, f.SeverityExitMap, f.QuietIfClean,
+		f.Output, f.Severity}
 }
 
 func (f *ReportFlagGroup) ToOptions(out io.Writer) (ReportOptions, error) {
@@ -144,6 +165,7 @@ func (f *ReportFlagGroup) ToOptions(out io.Writer) (ReportOptions, error) {
 	template := getString(f.Template)
 	dependencyTree := getBool(f.DependencyTree)
 	listAllPkgs := getBool(f.ListAllPkgs)
+	quietIfClean := getBool(f.QuietIfClean)
 	output := getString(f.Output)
 
 	if template != "" {
@@ -172,29 +194,59 @@ func (f *ReportFlagGroup) ToOptions(out io.Writer) (ReportOptions, error) {
 		}
 	}
 
+	// "--quiet-if-clean" option is unavailable with SBOM formats as they are generated regardless of security issues.
+	if quietIfClean && slices.Contains(report.SupportedSBOMFormats, format) {
+		log.Logger.Warnf(`"--quiet-if-clean" cannot be used with "--format %s".`, format)
+		quietIfClean = false
+	}
+
 	// Enable '--list-all-pkgs' if needed
 	if f.forceListAllPkgs(format, listAllPkgs, dependencyTree) {
 		listAllPkgs = true
 	}
 
-	if output != "" {
-		var err error
+	severities := splitSeverity(getStringSlice(f.Severity))
+	severityExitMap, err := parseSeverityExitMap(getString(f.SeverityExitMap))
+	if err != nil {
+		return ReportOptions{}, xerrors.Errorf("invalid '--severity-exit-map': %w", err)
+	}
+
+	// "--severity-exit-map" applies only to severities that remain after "--severity" filtering.
+	if len(severities) > 0 {
+		for i := range dbTypes.SeverityNames {
+			sev := dbTypes.Severity(i)
+			if _, ok := severityExitMap[sev]; ok && !slices.Contains(severities, sev) {
+				log.Logger.Warnf(`"--severity-exit-map" has %s, but it is ignored as "--severity" doesn't include %s.`, sev, sev)
+			}
+		}
+	}
+
+	switch {
+	case output != "" && quietIfClean:
+		// The output file should be left untouched when the report output is suppressed.
+		if err = checkOutputFile(output); err != nil {
+			return ReportOptions{}, xerrors.Errorf("failed to create an output file: %w", err)
+		}
+		out = &lazyFile{path: output}
+	case output != "":
 		if out, err = os.Create(output); err != nil {
 			return ReportOptions{}, xerrors.Errorf("failed to create an output file: %w", err)
 		}
 	}
 
 	return ReportOptions{
-		Format:         format,
-		ReportFormat:   getString(f.ReportFormat),
-		Template:       template,
-		DependencyTree: dependencyTree,
-		ListAllPkgs:    listAllPkgs,
-		IgnoreFile:     getString(f.IgnoreFile),
-		ExitCode:       getInt(f.ExitCode),
-		IgnorePolicy:   getString(f.IgnorePolicy),
-		Output:         out,
-		Severities:     splitSeverity(getStringSlice(f.Severity)),
+		Format:          format,
+		ReportFormat:    getString(f.ReportFormat),
+		Template:        template,
+		DependencyTree:  dependencyTree,
+		ListAllPkgs:     listAllPkgs,
+		IgnoreFile:      getString(f.IgnoreFile),
+		ExitCode:        getInt(f.ExitCode),
+		SeverityExitMap: severityExitMap,
+		QuietIfClean:    quietIfClean,
+		IgnorePolicy:    getString(f.IgnorePolicy),
+		Output:          out,
+		Severities:      severities,
 	}, nil
 }
 
@@ -230,3 +282,64 @@ func splitSeverity(severity []string) []dbTypes.Severity {
 	log.Logger.Debugf("Severities: %q", severities)
 	return severities
 }
+
+// lazyFile creates the file on the first write
+type lazyFile struct {
+	path string
+	file *os.File
+}
+
+func (f *lazyFile) Write(p []byte) (int, error) {
+	if f.file == nil {
+		var err error
+		if f.file, err = os.Create(f.path); err != nil {
+			return 0, xerrors.Errorf("failed to create an output file: %w", err)
+		}
+	}
+	return f.file.Write(p)
+}
+
+// checkOutputFile checks if the output file can be written without creating or truncating it
+func checkOutputFile(path string) error {
+	if _, err := os.Stat(path); err == nil {
+		// O_TRUNC is not specified so that the existing content is kept
+		f, err := os.OpenFile(path, os.O_WRONLY, 0)
+		if err != nil {
+			return err
+		}
+		return f.Close()
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(path), ".trivy-output-*")
+	if err != nil {
+		return err
+	}
+	_ = f.Close()
+	return os.Remove(f.Name())
+}
+
+// parseSeverityExitMap parses exit codes per severity, e.g. "CRITICAL=2,HIGH=1"
+func parseSeverityExitMap(s string) (map[dbTypes.Severity]int, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	exitMap := map[dbTypes.Severity]int{}
+	for _, pair := range strings.Split(s, ",") {
+		name, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, xerrors.Errorf("%q must be in the form of SEVERITY=CODE", pair)
+		}
+		sev, err := dbTypes.NewSeverity(strings.ToUpper(strings.TrimSpace(name)))
+		if err != nil {
+			return nil, xerrors.Errorf("severity error: %w", err)
+		}
+		// Exit codes are truncated to 8 bits on Unix, e.g. 256 would be 0
+		code, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || code < 0 || code > 255 {
+			return nil, xerrors.Errorf("invalid exit code for %s: %q (must be between 0 and 255)", sev, value)
+		}
+		exitMap[sev] = code
+	}
+	return exitMap, nil
+}
@@ -1,11 +1,14 @@
 package flag_test
 
 import (
+	"encoding/json"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest/observer"
 
@@ -13,20 +16,23 @@ import (
 	"github.com/aquasecurity/trivy/pkg/flag"
 	"github.com/aquasecurity/trivy/pkg/log"
 	"github.com/aquasecurity/trivy/pkg/report"
+	"github.com/aquasecurity/trivy/pkg/types"
 )
 
 func TestReportFlagGroup_ToOptions(t *testing.T) {
 	type fields struct {
-		format         string
-		template       string
-		dependencyTree bool
-		listAllPkgs    bool
-		ignoreUnfixed  bool
-		ignoreFile     string
-		exitCode       int
-		ignorePolicy   string
-		output         string
-		severities     string
+		format          string
+		template        string
+		dependencyTree  bool
+		listAllPkgs     bool
+		ignoreUnfixed   bool
+		ignoreFile      string
+		exitCode        int
+		severityExitMap string
+		quietIfClean    bool
+		ignorePolicy    string
+		output          string
+		severities      string
 
 		debug bool
 	}
@@ -35,6 +41,7 @@ func TestReportFlagGroup_ToOptions(t *testing.T) {
 		fields   fields
 		want     flag.ReportOptions
 		wantLogs []string
+		wantErr  string
 	}{
 		{
 			name:   "happy default (without flags)",
@@ -170,6 +177,87 @@ func TestReportFlagGroup_ToOptions(t *testing.T) {
 				ListAllPkgs: true,
 			},
 		},
+		{
+			name: "happy path with severity exit map",
+			fields: fields{
+				severities:      "CRITICAL,HIGH",
+				severityExitMap: "critical=2, HIGH=1,MEDIUM=0",
+			},
+			want: flag.ReportOptions{
+				Output: os.Stdout,
+				Severities: []dbTypes.Severity{
+					dbTypes.SeverityCritical,
+					dbTypes.SeverityHigh,
+				},
+				SeverityExitMap: map[dbTypes.Severity]int{
+					dbTypes.SeverityCritical: 2,
+					dbTypes.SeverityHigh:     1,
+					dbTypes.SeverityMedium:   0,
+				},
+			},
+			wantLogs: []string{
+				`"--severity-exit-map" has MEDIUM, but it is ignored as "--severity" doesn't include MEDIUM.`,
+			},
+		},
+		{
+			name: "happy path with quiet-if-clean",
+			fields: fields{
+				format:       "json",
+				severities:   "CRITICAL",
+				quietIfClean: true,
+			},
+			want: flag.ReportOptions{
+				Format:       "json",
+				Output:       os.Stdout,
+				Severities:   []dbTypes.Severity{dbTypes.SeverityCritical},
+				QuietIfClean: true,
+			},
+		},
+		{
+			name: "invalid option combination: --quiet-if-clean with --format cyclonedx",
+			fields: fields{
+				format:       "cyclonedx",
+				severities:   "CRITICAL",
+				quietIfClean: true,
+			},
+			wantLogs: []string{
+				`"--quiet-if-clean" cannot be used with "--format cyclonedx".`,
+			},
+			want: flag.ReportOptions{
+				Format:      "cyclonedx",
+				Output:      os.Stdout,
+				Severities:  []dbTypes.Severity{dbTypes.SeverityCritical},
+				ListAllPkgs: true,
+			},
+		},
+		{
+			name: "sad path with an unknown severity in severity exit map",
+			fields: fields{
+				severityExitMap: "INVALID=1",
+			},
+			wantErr: "unknown severity: INVALID",
+		},
+		{
+			name: "sad path with an invalid exit code in severity exit map",
+			fields: fields{
+				severityExitMap: "HIGH=one",
+			},
+			wantErr: `invalid exit code for HIGH: "one"`,
+		},
+		{
+			name: "sad path with an out-of-range exit code in severity exit map",
+			fields: fields{
+				severityExitMap: "HIGH=256",
+			},
+			wantErr: `invalid exit code for HIGH: "256" (must be between 0 and 255)`,
+		},
+		{
+			name: "sad path with a malformed severity exit map",
+			fields: fields{
+				severityExitMap: "HIGH",
+			},
+			wantErr: `"HIGH" must be in the form of SEVERITY=CODE`,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -188,23 +276,31 @@ func TestReportFlagGroup_ToOptions(t *testing.T) {
 			viper.Set(flag.IgnoreUnfixedFlag.ConfigName, tt.fields.ignoreUnfixed)
 			viper.Set(flag.IgnorePolicyFlag.ConfigName, tt.fields.ignorePolicy)
 			viper.Set(flag.ExitCodeFlag.ConfigName, tt.fields.exitCode)
+			viper.Set(flag.SeverityExitMapFlag.ConfigName, tt.fields.severityExitMap)
+			viper.Set(flag.QuietIfCleanFlag.ConfigName, tt.fields.quietIfClean)
 			viper.Set(flag.OutputFlag.ConfigName, tt.fields.output)
 			viper.Set(flag.SeverityFlag.ConfigName, tt.fields.severities)
 
 			// Assert options
 			f := &flag.ReportFlagGroup{
-				Format:         &flag.FormatFlag,
-				Template:       &flag.TemplateFlag,
-				DependencyTree: &flag.DependencyTreeFlag,
-				ListAllPkgs:    &flag.ListAllPkgsFlag,
-				IgnoreFile:     &flag.IgnoreFileFlag,
-				IgnorePolicy:   &flag.IgnorePolicyFlag,
-				ExitCode:       &flag.ExitCodeFlag,
-				Output:         &flag.OutputFlag,
-				Severity:       &flag.SeverityFlag,
+				Format:          &flag.FormatFlag,
+				Template:        &flag.TemplateFlag,
+				DependencyTree:  &flag.DependencyTreeFlag,
+				ListAllPkgs:     &flag.ListAllPkgsFlag,
+				IgnoreFile:      &flag.IgnoreFileFlag,
+				IgnorePolicy:    &flag.IgnorePolicyFlag,
+				ExitCode:        &flag.ExitCodeFlag,
+				SeverityExitMap: &flag.SeverityExitMapFlag,
+				QuietIfClean:    &flag.QuietIfCleanFlag,
+				Output:          &flag.OutputFlag,
+				Severity:        &flag.SeverityFlag,
 			}
 
 			got, err := f.ToOptions(os.Stdout)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
 			assert.NoError(t, err)
 			assert.Equalf(t, tt.want, got, "ToOptions()")
 
@@ -217,3 +313,102 @@ func TestReportFlagGroup_ToOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestReportFlagGroup_ToOptions_QuietIfCleanOutput(t *testing.T) {
+	tests := []struct {
+		name        string
+		output      string
+		existing    string
+		results     types.Results
+		wantExists  bool
+		wantContent string
+		wantErr     string
+	}{
+		{
+			name:       "clean results don't create the output file",
+			results:    types.Results{{Target: "test"}},
+			wantExists: false,
+		},
+		{
+			name:        "clean results don't truncate the existing output file",
+			existing:    "previous report",
+			results:     types.Results{{Target: "test"}},
+			wantExists:  true,
+			wantContent: "previous report",
+		},
+		{
+			name:     "failed results overwrite the existing output file",
+			existing: "previous report",
+			results: types.Results{
+				{
+					Target: "test",
+					Vulnerabilities: []types.DetectedVulnerability{
+						{
+							VulnerabilityID: "CVE-2021-0001",
+							PkgName:         "test",
+						},
+					},
+				},
+			},
+			wantExists:  true,
+			wantContent: "CVE-2021-0001",
+		},
+		{
+			name:    "sad path: the output directory doesn't exist",
+			output:  filepath.Join("no", "such", "dir", "result.json"),
+			wantErr: "failed to create an output file",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.output == "" {
+				tt.output = "result.json"
+			}
+			dir := t.TempDir()
+			output := filepath.Join(dir, tt.output)
+			if tt.existing != "" {
+				require.NoError(t, os.WriteFile(output, []byte(tt.existing), 0644))
+			}
+
+			viper.Set(flag.FormatFlag.ConfigName, report.FormatJSON)
+			viper.Set(flag.OutputFlag.ConfigName, output)
+			viper.Set(flag.QuietIfCleanFlag.ConfigName, true)
+			defer viper.Reset()
+
+			f := &flag.ReportFlagGroup{
+				Format:       &flag.FormatFlag,
+				QuietIfClean: &flag.QuietIfCleanFlag,
+				Output:       &flag.OutputFlag,
+			}
+			opts, err := f.ToOptions(os.Stdout)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			err = report.Write(types.Report{Results: tt.results}, report.Option{
+				Format:       opts.Format,
+				Output:       opts.Output,
+				QuietIfClean: opts.QuietIfClean,
+			})
+			require.NoError(t, err)
+
+			got, err := os.ReadFile(output)
+			if !tt.wantExists {
+				assert.ErrorIs(t, err, os.ErrNotExist)
+
+				// The file created to check the output directory must be removed
+				entries, err := os.ReadDir(dir)
+				require.NoError(t, err)
+				assert.Empty(t, entries)
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, string(got), tt.wantContent)
+			if tt.results.Failed() {
+				assert.True(t, json.Valid(got), "the output file must be overwritten with a valid JSON report")
+			}
+		})
+	}
+}
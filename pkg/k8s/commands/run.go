@@ -73,15 +73,16 @@ func run(ctx context.Context, opts flag.Options, cluster string, artifacts []*ar
 	}
 
 	if err := report.Write(r, report.Option{
-		Format:     opts.Format,
-		Report:     opts.ReportFormat,
-		Output:     opts.Output,
-		Severities: opts.Severities,
+		Format:       opts.Format,
+		Report:       opts.ReportFormat,
+		Output:       opts.Output,
+		Severities:   opts.Severities,
+		QuietIfClean: opts.QuietIfClean,
 	}, opts.ScanOptions.SecurityChecks, showEmpty); err != nil {
 		return xerrors.Errorf("unable to write results: %w", err)
 	}
 
-	cmd.Exit(opts, r.Failed())
+	cmd.Exit(opts, r.Results())
 
 	return nil
 }
@@ -32,6 +32,7 @@ type Option struct {
 	Output        io.Writer
 	Severities    []dbTypes.Severity
 	ColumnHeading []string
+	QuietIfClean  bool
 }
 
 // Report represents a kubernetes scan report
@@ -67,21 +68,21 @@ func (r Resource) fullname() string {
 	return strings.ToLower(fmt.Sprintf("%s/%s/%s", r.Namespace, r.Kind, r.Name))
 }
 
-// Failed returns whether the k8s report includes any vulnerabilities or misconfigurations
-func (r Report) Failed() bool {
-	for _, r := range r.Vulnerabilities {
-		if r.Results.Failed() {
-			return true
-		}
+// Results returns the results of all the scanned resources
+func (r Report) Results() types.Results {
+	var results types.Results
+	for _, resource := range r.Vulnerabilities {
+		results = append(results, resource.Results...)
 	}
-
-	for _, r := range r.Misconfigurations {
-		if r.Results.Failed() {
-			return true
-		}
+	for _, resource := range r.Misconfigurations {
+		results = append(results, resource.Results...)
 	}
+	return results
+}
 
-	return false
+// Failed returns whether the k8s report includes any vulnerabilities or misconfigurations
+func (r Report) Failed() bool {
+	return r.Results().Failed()
 }
 
 func (r Report) empty() bool {
@@ -132,6 +133,11 @@ type Writer interface {
 func Write(report Report, option Option, securityChecks []string, showEmpty bool) error {
 	report.printErrors()
 
+	if option.QuietIfClean && !report.Failed() {
+		log.Logger.Debug("No security issues found, the report output is suppressed by '--quiet-if-clean'")
+		return nil
+	}
+
 	switch option.Format {
 	case jsonFormat:
 		jwriter := JSONWriter{Output: option.Output, Report: option.Report}
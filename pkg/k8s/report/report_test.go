@@ -1,10 +1,13 @@
 package report
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	dbTypes "github.com/aquasecurity/trivy-db/pkg/types"
 	"github.com/aquasecurity/trivy/pkg/flag"
 	"github.com/aquasecurity/trivy/pkg/types"
 )
@@ -174,6 +177,139 @@ func TestResourceFailed(t *testing.T) {
 	}
 }
 
+func TestReport_Results(t *testing.T) {
+	deployNginxWithVulns := Resource{
+		Namespace: "default",
+		Kind:      "Deploy",
+		Name:      "nginx",
+		Results: types.Results{
+			{
+				Vulnerabilities: []types.DetectedVulnerability{
+					{
+						VulnerabilityID: "CVE-2022-0001",
+						Vulnerability:   dbTypes.Vulnerability{Severity: dbTypes.SeverityCritical.String()},
+					},
+					{
+						VulnerabilityID: "CVE-2022-0002",
+						Vulnerability:   dbTypes.Vulnerability{Severity: dbTypes.SeverityLow.String()},
+					},
+				},
+			},
+		},
+	}
+	deployNginxWithMisconfigs := Resource{
+		Namespace: "default",
+		Kind:      "Deploy",
+		Name:      "nginx",
+		Results: types.Results{
+			{
+				Misconfigurations: []types.DetectedMisconfiguration{
+					{ID: "KSV001", Severity: dbTypes.SeverityMedium.String(), Status: types.StatusFailure},
+					{ID: "KSV002", Severity: dbTypes.SeverityHigh.String(), Status: types.StatusPassed},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		report         Report
+		wantResults    types.Results
+		wantSeverities []dbTypes.Severity
+	}{
+		{
+			name: "report with mixed severities",
+			report: Report{
+				Vulnerabilities:   []Resource{deployNginxWithVulns},
+				Misconfigurations: []Resource{deployNginxWithMisconfigs},
+			},
+			wantResults: types.Results{
+				deployNginxWithVulns.Results[0],
+				deployNginxWithMisconfigs.Results[0],
+			},
+			wantSeverities: []dbTypes.Severity{
+				dbTypes.SeverityLow,
+				dbTypes.SeverityMedium,
+				dbTypes.SeverityCritical,
+			},
+		},
+		{
+			name:   "report without vulnerabilities and misconfigurations",
+			report: Report{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.report.Results()
+			assert.Equal(t, tt.wantResults, got)
+			assert.Equal(t, tt.wantSeverities, got.Severities())
+		})
+	}
+}
+
+func TestWrite_QuietIfClean(t *testing.T) {
+	cleanReport := Report{
+		ClusterName:       "test",
+		Misconfigurations: []Resource{podPrometheusWithMisconfigs},
+	}
+	failedReport := Report{
+		ClusterName:     "test",
+		Vulnerabilities: []Resource{deployOrionWithVulns},
+	}
+
+	tests := []struct {
+		name       string
+		format     string
+		report     string
+		k8sReport  Report
+		wantOutput bool
+	}{
+		{
+			name:       "json with no security issues",
+			format:     jsonFormat,
+			report:     allReport,
+			k8sReport:  cleanReport,
+			wantOutput: false,
+		},
+		{
+			name:       "json with vulnerabilities",
+			format:     jsonFormat,
+			report:     allReport,
+			k8sReport:  failedReport,
+			wantOutput: true,
+		},
+		{
+			name:       "table with no security issues",
+			format:     tableFormat,
+			report:     summaryReport,
+			k8sReport:  cleanReport,
+			wantOutput: false,
+		},
+		{
+			name:       "table with vulnerabilities",
+			format:     tableFormat,
+			report:     summaryReport,
+			k8sReport:  failedReport,
+			wantOutput: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := bytes.NewBuffer(nil)
+			err := Write(tt.k8sReport, Option{
+				Format:       tt.format,
+				Report:       tt.report,
+				Output:       output,
+				Severities:   []dbTypes.Severity{dbTypes.SeverityCritical},
+				QuietIfClean: true,
+			}, []string{types.SecurityCheckVulnerability, types.SecurityCheckConfig}, true)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOutput, output.Len() > 0)
+		})
+	}
+}
+
 func Test_rbacResource(t *testing.T) {
 	tests := []struct {
 		name      string
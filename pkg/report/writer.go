@@ -50,10 +50,18 @@ type Option struct {
 	// For licenses
 	LicenseRiskThreshold int
 	IgnoredLicenses      []string
+
+	// Suppress the output when no security issues are found
+	QuietIfClean bool
 }
 
 // Write writes the result to output, format as passed in argument
 func Write(report types.Report, option Option) error {
+	if option.QuietIfClean && !report.Results.Failed() {
+		log.Logger.Debug("No security issues found, the report output is suppressed by '--quiet-if-clean'")
+		return nil
+	}
+
 	var writer Writer
 	switch option.Format {
 	case FormatTable:
@@ -1,10 +1,14 @@
 package report_test
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	dbTypes "github.com/aquasecurity/trivy-db/pkg/types"
+	"github.com/aquasecurity/trivy/pkg/report"
 	"github.com/aquasecurity/trivy/pkg/types"
 )
 
@@ -82,3 +86,80 @@ func TestResults_Failed(t *testing.T) {
 		})
 	}
 }
+
+func TestWrite_QuietIfClean(t *testing.T) {
+	cleanResults := types.Results{
+		{
+			Target: "test",
+			Type:   "test",
+			Misconfigurations: []types.DetectedMisconfiguration{
+				{
+					Type:   "Docker Security Check",
+					ID:     "ID-001",
+					Status: types.StatusPassed,
+				},
+			},
+		},
+	}
+	failedResults := types.Results{
+		{
+			Target: "test",
+			Type:   "test",
+			Vulnerabilities: []types.DetectedVulnerability{
+				{
+					VulnerabilityID:  "CVE-2021-0001",
+					PkgName:          "test",
+					InstalledVersion: "1.2.3",
+					Vulnerability: dbTypes.Vulnerability{
+						Severity: dbTypes.SeverityHigh.String(),
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		format     string
+		results    types.Results
+		wantOutput bool
+	}{
+		{
+			name:       "json with no security issues",
+			format:     report.FormatJSON,
+			results:    cleanResults,
+			wantOutput: false,
+		},
+		{
+			name:       "json with vulnerabilities",
+			format:     report.FormatJSON,
+			results:    failedResults,
+			wantOutput: true,
+		},
+		{
+			name:       "table with no security issues",
+			format:     report.FormatTable,
+			results:    cleanResults,
+			wantOutput: false,
+		},
+		{
+			name:       "table with vulnerabilities",
+			format:     report.FormatTable,
+			results:    failedResults,
+			wantOutput: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := bytes.NewBuffer(nil)
+			err := report.Write(types.Report{Results: tt.results}, report.Option{
+				Format:       tt.format,
+				Output:       output,
+				Severities:   []dbTypes.Severity{dbTypes.SeverityHigh},
+				QuietIfClean: true,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOutput, output.Len() > 0)
+		})
+	}
+}
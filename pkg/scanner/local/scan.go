@@ -414,8 +414,10 @@ func toDetectedMisconfiguration(res ftypes.MisconfResult, defaultSeverity dbType
 	status types.MisconfStatus, layer ftypes.Layer) types.DetectedMisconfiguration {
 
 	severity := defaultSeverity
-	sev, err := dbTypes.NewSeverity(res.Severity)
-	if err != nil {
+	if res.Severity == "" {
+		// Policies without severity, e.g. custom policies, are reported as UNKNOWN
+		severity = dbTypes.SeverityUnknown
+	} else if sev, err := dbTypes.NewSeverity(res.Severity); err != nil {
 		log.Logger.Warnf("severity must be %s, but %s", dbTypes.SeverityNames, res.Severity)
 	} else {
 		severity = sev
@@ -664,6 +664,18 @@ func TestScanner_Scan(t *testing.T) {
 							{
 								FileType: ftypes.Kubernetes,
 								FilePath: "/app/configs/pod.yaml",
+								Failures: ftypes.MisconfResults{
+									{
+										Namespace: "main.kubernetes.id400",
+										Message:   "something bad",
+										PolicyMetadata: ftypes.PolicyMetadata{
+											ID:       "ID400",
+											Type:     "Kubernetes Security Check",
+											Title:    "Bad Pod",
+											Severity: "",
+										},
+									},
+								},
 								Warnings: []ftypes.MisconfResult{
 									{
 										Namespace: "main.kubernetes.id300",
@@ -765,6 +777,18 @@ func TestScanner_Scan(t *testing.T) {
 					Class:  types.ClassConfig,
 					Type:   ftypes.Kubernetes,
 					Misconfigurations: []types.DetectedMisconfiguration{
+						{
+							Type:      "Kubernetes Security Check",
+							ID:        "ID400",
+							Title:     "Bad Pod",
+							Message:   "something bad",
+							Namespace: "main.kubernetes.id400",
+							Severity:  "UNKNOWN",
+							Status:    types.StatusFailure,
+							Layer: ftypes.Layer{
+								DiffID: "sha256:9922bc15eeefe1637b803ef2106f178152ce19a391f24aec838cbe2e48e73303",
+							},
+						},
 						{
 							Type:      "Kubernetes Security Check",
 							ID:        "ID300",
@@ -5,6 +5,7 @@ import (
 
 	v1 "github.com/google/go-containerregistry/pkg/v1" // nolint: goimports
 
+	dbTypes "github.com/aquasecurity/trivy-db/pkg/types"
 	ftypes "github.com/aquasecurity/trivy/pkg/fanal/types"
 )
 
@@ -120,3 +121,41 @@ func (results Results) Failed() bool {
 	}
 	return false
 }
+
+// Severities returns the unique severities of the security issues included in the results
+func (results Results) Severities() []dbTypes.Severity {
+	uniq := map[dbTypes.Severity]struct{}{}
+	add := func(severity string) {
+		sev, err := dbTypes.NewSeverity(severity)
+		if err != nil {
+			sev = dbTypes.SeverityUnknown
+		}
+		uniq[sev] = struct{}{}
+	}
+
+	for _, r := range results {
+		for _, vuln := range r.Vulnerabilities {
+			add(vuln.Severity)
+		}
+		for _, m := range r.Misconfigurations {
+			if m.Status == StatusFailure {
+				add(m.Severity)
+			}
+		}
+		for _, secret := range r.Secrets {
+			add(secret.Severity)
+		}
+		for _, license := range r.Licenses {
+			add(license.Severity)
+		}
+	}
+
+	// Sort severities in ascending order
+	var severities []dbTypes.Severity
+	for i := range dbTypes.SeverityNames {
+		if _, ok := uniq[dbTypes.Severity(i)]; ok {
+			severities = append(severities, dbTypes.Severity(i))
+		}
+	}
+	return severities
+}
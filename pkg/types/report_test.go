@@ -0,0 +1,125 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	dbTypes "github.com/aquasecurity/trivy-db/pkg/types"
+	ftypes "github.com/aquasecurity/trivy/pkg/fanal/types"
+	"github.com/aquasecurity/trivy/pkg/types"
+)
+
+func TestResults_Severities(t *testing.T) {
+	tests := []struct {
+		name    string
+		results types.Results
+		want    []dbTypes.Severity
+	}{
+		{
+			name: "mixed severities",
+			results: types.Results{
+				{
+					Target: "test",
+					Vulnerabilities: []types.DetectedVulnerability{
+						{
+							VulnerabilityID: "CVE-2022-0001",
+							Vulnerability:   dbTypes.Vulnerability{Severity: dbTypes.SeverityCritical.String()},
+						},
+						{
+							VulnerabilityID: "CVE-2022-0002",
+							Vulnerability:   dbTypes.Vulnerability{Severity: dbTypes.SeverityLow.String()},
+						},
+						{
+							VulnerabilityID: "CVE-2022-0003",
+							Vulnerability:   dbTypes.Vulnerability{Severity: dbTypes.SeverityCritical.String()},
+						},
+					},
+				},
+				{
+					Target: "test",
+					Misconfigurations: []types.DetectedMisconfiguration{
+						{
+							ID:       "ID-001",
+							Severity: dbTypes.SeverityMedium.String(),
+							Status:   types.StatusFailure,
+						},
+					},
+					Secrets: []ftypes.SecretFinding{
+						{
+							RuleID:   "aws-access-key-id",
+							Severity: dbTypes.SeverityHigh.String(),
+						},
+					},
+				},
+			},
+			want: []dbTypes.Severity{
+				dbTypes.SeverityLow,
+				dbTypes.SeverityMedium,
+				dbTypes.SeverityHigh,
+				dbTypes.SeverityCritical,
+			},
+		},
+		{
+			name: "passed misconfigurations are ignored",
+			results: types.Results{
+				{
+					Target: "test",
+					Misconfigurations: []types.DetectedMisconfiguration{
+						{
+							ID:       "ID-001",
+							Severity: dbTypes.SeverityCritical.String(),
+							Status:   types.StatusPassed,
+						},
+						{
+							ID:       "ID-002",
+							Severity: dbTypes.SeverityLow.String(),
+							Status:   types.StatusFailure,
+						},
+					},
+				},
+			},
+			want: []dbTypes.Severity{
+				dbTypes.SeverityLow,
+			},
+		},
+		{
+			name: "empty and invalid severities are UNKNOWN",
+			results: types.Results{
+				{
+					Target: "test",
+					Secrets: []ftypes.SecretFinding{
+						{
+							RuleID:   "custom-rule",
+							Severity: "",
+						},
+					},
+					Licenses: []types.DetectedLicense{
+						{
+							Name:     "GPL-3.0",
+							Severity: "INVALID",
+						},
+					},
+				},
+			},
+			want: []dbTypes.Severity{
+				dbTypes.SeverityUnknown,
+			},
+		},
+		{
+			name: "no security issues",
+			results: types.Results{
+				{
+					Target: "test",
+				},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.results.Severities()
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}